@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: MIT
+// © 2025 Austin Gause <a.gause@outlook.com>
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var daemonIntervalSeconds int
+
+// daemonCmd runs in the foreground (intended to be backgrounded by the
+// caller's init system or `&`), periodically auto-saving the tmux server
+// state to the "auto" snapshot until interrupted.
+var daemonCmd = &cobra.Command{
+	Use:   "daemon",
+	Short: "Periodically auto-save the tmux session snapshot",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		interval := time.Duration(daemonIntervalSeconds) * time.Second
+		if interval <= 0 {
+			return fmt.Errorf("--interval must be positive")
+		}
+
+		path, err := snapshotPath("auto")
+		if err != nil {
+			return err
+		}
+
+		sig := make(chan os.Signal, 1)
+		signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		fmt.Fprintf(os.Stderr, "tms daemon: auto-saving to %s every %s\n", path, interval)
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := saveSnapshot(path); err != nil {
+					fmt.Fprintln(os.Stderr, "tms daemon: auto-save failed:", err)
+				}
+			case <-sig:
+				return nil
+			}
+		}
+	},
+}
+
+func init() { // {{{
+	rootCmd.AddCommand(daemonCmd)
+	daemonCmd.Flags().IntVar(&daemonIntervalSeconds, "interval", 300, "Seconds between auto-saves")
+} // }}}
@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: MIT
+// © 2025 Austin Gause <a.gause@outlook.com>
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Pairadux/Tmux-Sessionizer/internal/history"
+
+	"github.com/spf13/cobra"
+)
+
+// historyCmd groups subcommands for inspecting and editing the frecency
+// history used to order the picker list.
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "Inspect or edit the picker's frecency history",
+}
+
+var historyShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Print every entry in the frecency history",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		hist, err := history.Load()
+		if err != nil {
+			return err
+		}
+
+		if len(hist) == 0 {
+			fmt.Println("No history recorded yet")
+			return nil
+		}
+
+		for name, entry := range hist {
+			fmt.Printf("%s\tcount=%d\tlast_used=%s\n", name, entry.Count, time.Unix(entry.LastUsed, 0).Format(time.RFC3339))
+		}
+
+		return nil
+	},
+}
+
+var historyClearCmd = &cobra.Command{
+	Use:   "clear",
+	Short: "Delete all frecency history",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return history.Save(history.Store{})
+	},
+}
+
+var historyForgetCmd = &cobra.Command{
+	Use:   "forget <name>",
+	Short: "Remove a single entry from the frecency history",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		hist, err := history.Load()
+		if err != nil {
+			return err
+		}
+
+		hist.Forget(args[0])
+
+		return history.Save(hist)
+	},
+}
+
+func init() { // {{{
+	rootCmd.AddCommand(historyCmd)
+	historyCmd.AddCommand(historyShowCmd, historyClearCmd, historyForgetCmd)
+} // }}}
@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: MIT
+// © 2025 Austin Gause <a.gause@outlook.com>
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/Pairadux/Tmux-Sessionizer/internal/projectcfg"
+
+	"github.com/spf13/cobra"
+)
+
+// listCmd prints the names of every project file under
+// ~/.config/tms/projects.
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List available named projects",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		names, err := projectcfg.ListNames()
+		if err != nil {
+			return err
+		}
+
+		if len(names) == 0 {
+			dir, _ := projectcfg.ProjectsDir()
+			fmt.Printf("No projects found in %s\n", dir)
+			return nil
+		}
+
+		for _, name := range names {
+			fmt.Println(name)
+		}
+
+		return nil
+	},
+}
+
+func init() { // {{{
+	rootCmd.AddCommand(listCmd)
+} // }}}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: MIT
+// © 2025 Austin Gause <a.gause@outlook.com>
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Pairadux/Tmux-Sessionizer/internal/models"
+)
+
+// splitProjectArg splits a "<project>[:window[,window...]]" argument, as
+// accepted by `tms start`, into the project name and the (possibly empty)
+// list of windows to restrict the start to.
+func splitProjectArg(arg string) (project string, windows []string) {
+	project, windowList, found := strings.Cut(arg, ":")
+	if !found || windowList == "" {
+		return project, nil
+	}
+
+	return project, strings.Split(windowList, ",")
+}
+
+// findWindow returns the window named name from layout, if present.
+func findWindow(layout models.SessionLayout, name string) (models.Window, bool) {
+	for _, window := range layout.Windows {
+		if window.Name == name {
+			return window, true
+		}
+	}
+
+	return models.Window{}, false
+}
+
+// filterWindows resolves each requested window name against layout,
+// returning an error naming the first one that doesn't exist.
+func filterWindows(layout models.SessionLayout, names []string) ([]models.Window, error) {
+	windows := make([]models.Window, 0, len(names))
+
+	for _, name := range names {
+		window, ok := findWindow(layout, name)
+		if !ok {
+			return nil, fmt.Errorf("no window named %q in this project", name)
+		}
+		windows = append(windows, window)
+	}
+
+	return windows, nil
+}
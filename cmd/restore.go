@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: MIT
+// © 2025 Austin Gause <a.gause@outlook.com>
+
+package cmd
+
+import (
+	"github.com/Pairadux/Tmux-Sessionizer/internal/snapshot"
+
+	"github.com/spf13/cobra"
+)
+
+// restoreCmd recreates the sessions captured by a previous `tms save`.
+var restoreCmd = &cobra.Command{
+	Use:   "restore [name]",
+	Short: "Recreate sessions from a snapshot taken with `tms save`",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := "default"
+		if len(args) == 1 {
+			name = args[0]
+		}
+
+		path, err := snapshotPath(name)
+		if err != nil {
+			return err
+		}
+
+		snap, err := snapshot.Load(path)
+		if err != nil {
+			return err
+		}
+
+		return snapshot.Restore(snap)
+	},
+}
+
+func init() { // {{{
+	rootCmd.AddCommand(restoreCmd)
+} // }}}
@@ -5,15 +5,19 @@ package cmd
 
 // IMPORTS {{{
 import (
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
-	"slices"
 	"strings"
+	"time"
 
-	"github.com/Pairadux/Tmux-Sessionizer/internal/fzf"
+	"github.com/Pairadux/Tmux-Sessionizer/internal/history"
+	"github.com/Pairadux/Tmux-Sessionizer/internal/interpolate"
 	"github.com/Pairadux/Tmux-Sessionizer/internal/models"
+	"github.com/Pairadux/Tmux-Sessionizer/internal/picker"
+	"github.com/Pairadux/Tmux-Sessionizer/internal/projectcfg"
 	"github.com/Pairadux/Tmux-Sessionizer/internal/tmux"
 	"github.com/Pairadux/Tmux-Sessionizer/internal/utility"
 
@@ -26,6 +30,7 @@ var (
 	cfgFileFlag string
 	cfgFilePath string
 	verbose     bool
+	setFlags    []string
 )
 
 
@@ -41,9 +46,19 @@ var rootCmd = &cobra.Command{
 			return nil
 		}
 
-		if err := verifyExternalUtils(); err != nil {
+		pickerName := ""
+		if !isConfiglessCommand(cmd) {
+			pickerName = resolvePickerName(cmd)
+		}
+
+		if err := verifyExternalUtils(pickerName); err != nil {
 			return err
 		}
+
+		if isConfiglessCommand(cmd) {
+			return nil
+		}
+
 		if err := validateConfig(); err != nil {
 			return err
 		}
@@ -91,21 +106,33 @@ var rootCmd = &cobra.Command{
 				names = append(names, name)
 			}
 
-			slices.SortFunc(names, func(a, b string) int {
-				isTmuxA := strings.HasPrefix(a, cfg.TmuxSessionPrefix)
-				isTmuxB := strings.HasPrefix(b, cfg.TmuxSessionPrefix)
-				if isTmuxA && !isTmuxB {
-					return -1
-				}
-				if !isTmuxA && isTmuxB {
-					return 1
+			hist, err := history.Load()
+			if err != nil {
+				return err
+			}
+
+			names = history.Sort(names, func(name string) bool {
+				return strings.HasPrefix(name, cfg.TmuxSessionPrefix)
+			}, hist, cfg.Sort, history.ParseHalfLife(cfg.FrecencyHalfLife))
+
+			items := make([]picker.Item, len(names))
+			for i, name := range names {
+				path := entries[name]
+				// [TMUX] entries map to a session name, not a directory.
+				if !filepath.IsAbs(path) {
+					path = ""
 				}
-				return strings.Compare(a, b)
-			})
+				items[i] = picker.Item{Label: name, Path: path}
+			}
+
+			p, err := picker.Get(resolvePickerName(cmd))
+			if err != nil {
+				cobra.CheckErr(err)
+			}
 
-			choiceStr, err = fzf.SelectWithFzf(names)
+			choiceStr, err = p.Select(items)
 			if err != nil {
-				if err.Error() == "user cancelled" {
+				if errors.Is(err, picker.ErrCancelled) {
 					return nil
 				}
 				cobra.CheckErr(err)
@@ -123,19 +150,57 @@ var rootCmd = &cobra.Command{
 			return fmt.Errorf("The name must match an existing directory entry: %s", choiceStr)
 		}
 
-		// IDEA: this is a bit involved, but I want to retrieve a session layout from a .tms file in the directory of the session to be created, if present
-		// This would enable dynamic session layouts based on user preference/setup
+		layout := cfg.SessionLayout
+		settings := cfg.TemplateSettings()
+
+		noProjectConfig, _ := cmd.Flags().GetBool("no-project-config")
+		if !noProjectConfig && exists {
+			projectLayout, projectSettings, ok, err := projectcfg.Load(selectedPath)
+			if err != nil {
+				return fmt.Errorf("invalid project config: %w", err)
+			}
+			if ok {
+				layout = projectcfg.Merge(layout, projectLayout)
+				settings = projectcfg.MergeSettings(settings, projectSettings)
+			}
+		}
+
+		overrides, err := parseSetFlags(setFlags)
+		if err != nil {
+			return err
+		}
+		settings = projectcfg.MergeSettings(settings, overrides)
+
+		vars := interpolate.Vars{
+			SessionName: sessionName,
+			SessionPath: selectedPath,
+			ProjectRoot: selectedPath,
+			Settings:    settings,
+		}
+
+		resolvedSettings, err := interpolate.ResolveSettings(settings, vars)
+		if err != nil {
+			return fmt.Errorf("failed to resolve settings: %w", err)
+		}
+		vars.Settings = resolvedSettings
+
+		layout, err = interpolate.ExpandLayout(layout, vars)
+		if err != nil {
+			return fmt.Errorf("failed to expand session_layout: %w", err)
+		}
 
 		session := models.Session{
 			Name:   sessionName,
 			Path:   selectedPath,
-			Layout: cfg.SessionLayout,
+			Layout: layout,
 		}
 
 		if err := tmux.CreateAndSwitchSession(&cfg, session); err != nil {
 			return fmt.Errorf("Failed to switch session: %w", err)
 		}
 
+		recordHistory(choiceStr)
+
 		return nil
 	},
 }
@@ -153,7 +218,10 @@ func init() { // {{{
 	cobra.OnInitialize(initConfig)
 	rootCmd.PersistentFlags().StringVar(&cfgFileFlag, "config", "", "config file (default $XDG_CONFIG_HOME/tms/config.yaml)")
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "Enable verbose output")
+	rootCmd.PersistentFlags().StringArrayVar(&setFlags, "set", nil, "Override a template variable (key=value, repeatable)")
 	rootCmd.Flags().IntP("depth", "d", 0, "Maximum traversal depth")
+	rootCmd.Flags().Bool("no-project-config", false, "Ignore any .tms.yaml/.tms/config.yaml in the selected directory")
+	rootCmd.Flags().String("picker", "", "Picker backend to use (fzf, sk, fzy, gum, builtin); defaults to picker: in config, then \"fzf\"")
 } // }}}
 
 // initConfig reads in config file and ENV variables if set.
@@ -350,6 +418,49 @@ func shouldSkipEntry(displayName, currentSession string, existingSessions map[st
 	return displayName == currentSession || existingSessions[displayName]
 }
 
+// recordHistory bumps name's frecency record. A failure to persist it is
+// reported but shouldn't stop the session switch that already happened.
+func recordHistory(name string) {
+	hist, err := history.Load()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Warning: failed to load history:", err)
+		return
+	}
+
+	hist.Record(name, time.Now())
+
+	if err := history.Save(hist); err != nil {
+		fmt.Fprintln(os.Stderr, "Warning: failed to save history:", err)
+	}
+}
+
+// resolvePickerName resolves the picker backend to use, preferring the
+// --picker flag, then the picker: config key, then "fzf".
+func resolvePickerName(cmd *cobra.Command) string {
+	if name, _ := cmd.Flags().GetString("picker"); name != "" {
+		return name
+	}
+	if cfg.Picker != "" {
+		return cfg.Picker
+	}
+	return "fzf"
+}
+
+// parseSetFlags turns repeated --set key=value flags into a settings map.
+func parseSetFlags(flags []string) (map[string]string, error) {
+	overrides := make(map[string]string, len(flags))
+
+	for _, flag := range flags {
+		key, value, found := strings.Cut(flag, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid --set %q: expected key=value", flag)
+		}
+		overrides[key] = value
+	}
+
+	return overrides, nil
+}
+
 // isConfigCommand checks if the given command or any of its parent commands
 // is "config". This is used to skip config validation for commands like
 // "tms config init" or "tms config edit", which are intended to manage or
@@ -364,6 +475,23 @@ func isConfigCommand(cmd *cobra.Command) bool {
 	return false
 }
 
+// isConfiglessCommand reports whether cmd (or one of its parents) is one of
+// the subcommands that don't drive the fuzzy-picker scan/entry flow —
+// start/stop/list read named project files, save/restore/daemon talk
+// to the tmux server directly, and history only edits the frecency
+// store — so none of them need scan_dirs, entry_dirs,
+// or session_layout to be configured.
+func isConfiglessCommand(cmd *cobra.Command) bool {
+	for c := cmd; c != nil; c = c.Parent() {
+		switch c.Name() {
+		case "start", "stop", "list", "save", "restore", "daemon", "history":
+			return true
+		}
+	}
+
+	return false
+}
+
 // validateConfig ensures that the application configuration is valid and complete.
 // It checks for the presence of a config file and verifies that at least one
 // directory is configured for scanning (either scan_dirs or entry_dirs).
@@ -402,14 +530,21 @@ func warnOnConfigIssues() {
 	}
 }
 
-func verifyExternalUtils() error {
+// verifyExternalUtils checks that tmux is installed, plus whichever binary
+// pickerName's backend needs (pickerName == "" skips the picker check
+// entirely, e.g. for subcommands that never show the interactive picker;
+// the builtin backend also needs no external binary).
+func verifyExternalUtils(pickerName string) error {
 	var missing []string
 
 	if _, err := exec.LookPath("tmux"); err != nil {
 		missing = append(missing, "tmux")
 	}
-	if _, err := exec.LookPath("fzf"); err != nil {
-		missing = append(missing, "fzf")
+
+	if binary := picker.Binary(pickerName); binary != "" {
+		if _, err := exec.LookPath(binary); err != nil {
+			missing = append(missing, binary)
+		}
 	}
 
 	if len(missing) > 0 {
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: MIT
+// © 2025 Austin Gause <a.gause@outlook.com>
+
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/Pairadux/Tmux-Sessionizer/internal/snapshot"
+
+	"github.com/spf13/cobra"
+)
+
+// saveCmd snapshots every running tmux session to a named YAML file under
+// $XDG_DATA_HOME/tms/snapshots.
+var saveCmd = &cobra.Command{
+	Use:   "save [name]",
+	Short: "Snapshot the current tmux server state for later restore",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := "default"
+		if len(args) == 1 {
+			name = args[0]
+		}
+
+		path, err := snapshotPath(name)
+		if err != nil {
+			return err
+		}
+
+		return saveSnapshot(path)
+	},
+}
+
+func init() { // {{{
+	rootCmd.AddCommand(saveCmd)
+} // }}}
+
+// snapshotPath resolves a snapshot name to its file path under the
+// snapshots directory.
+func snapshotPath(name string) (string, error) {
+	dir, err := snapshot.Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, name+".yaml"), nil
+}
+
+// saveSnapshot captures the live tmux state and writes it to path, shared
+// by `tms save` and the `tms daemon` auto-save loop.
+func saveSnapshot(path string) error {
+	snap, err := snapshot.Capture()
+	if err != nil {
+		return fmt.Errorf("failed to capture tmux state: %w", err)
+	}
+
+	if err := snapshot.Save(path, snap); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: MIT
+// © 2025 Austin Gause <a.gause@outlook.com>
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/Pairadux/Tmux-Sessionizer/internal/interpolate"
+	"github.com/Pairadux/Tmux-Sessionizer/internal/models"
+	"github.com/Pairadux/Tmux-Sessionizer/internal/projectcfg"
+	"github.com/Pairadux/Tmux-Sessionizer/internal/tmux"
+	"github.com/Pairadux/Tmux-Sessionizer/internal/utility"
+
+	"github.com/spf13/cobra"
+)
+
+var startWindows []string
+
+// startCmd builds (or attaches to) a session from a named project file
+// under ~/.config/tms/projects.
+var startCmd = &cobra.Command{
+	Use:   "start <project>[:window[,window...]]",
+	Short: "Start or attach to a named project",
+	Long: "Start or attach to a named project defined in ~/.config/tms/projects/<name>.yaml.\n\n" +
+		"If the session already exists, tms attaches to it instead of recreating it,\n" +
+		"unless specific windows are named (via \"project:window\" or repeated -w), in\n" +
+		"which case only those windows are (re)created in the running session.",
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name, windowNames := splitProjectArg(args[0])
+		windowNames = append(windowNames, startWindows...)
+
+		layout, settings, err := projectcfg.LoadNamed(name)
+		if err != nil {
+			return err
+		}
+
+		if layout.Root == "" {
+			return fmt.Errorf("project %q has no root configured", name)
+		}
+
+		root, err := utility.ResolvePath(layout.Root)
+		if err != nil {
+			return err
+		}
+		layout.Root = root
+
+		overrides, err := parseSetFlags(setFlags)
+		if err != nil {
+			return err
+		}
+		settings = projectcfg.MergeSettings(settings, overrides)
+
+		vars := interpolate.Vars{SessionName: name, SessionPath: root, ProjectRoot: root, Settings: settings}
+
+		resolvedSettings, err := interpolate.ResolveSettings(settings, vars)
+		if err != nil {
+			return fmt.Errorf("failed to resolve settings: %w", err)
+		}
+		vars.Settings = resolvedSettings
+
+		layout, err = interpolate.ExpandLayout(layout, vars)
+		if err != nil {
+			return fmt.Errorf("failed to expand project layout: %w", err)
+		}
+
+		if len(windowNames) > 0 && tmux.GetTmuxSessionSet()[name] {
+			windows, err := filterWindows(layout, windowNames)
+			if err != nil {
+				return err
+			}
+
+			for _, window := range windows {
+				if err := tmux.EnsureWindow(name, root, window); err != nil {
+					return err
+				}
+			}
+
+			return tmux.Switch(name)
+		}
+
+		session := models.Session{Name: name, Path: root, Layout: layout}
+
+		return tmux.CreateAndSwitchSession(&cfg, session)
+	},
+}
+
+func init() { // {{{
+	rootCmd.AddCommand(startCmd)
+	startCmd.Flags().StringArrayVarP(&startWindows, "window", "w", nil, "Only (re)create this window in an already-running session (repeatable)")
+} // }}}
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: MIT
+// © 2025 Austin Gause <a.gause@outlook.com>
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/Pairadux/Tmux-Sessionizer/internal/interpolate"
+	"github.com/Pairadux/Tmux-Sessionizer/internal/projectcfg"
+	"github.com/Pairadux/Tmux-Sessionizer/internal/tmux"
+	"github.com/Pairadux/Tmux-Sessionizer/internal/utility"
+
+	"github.com/spf13/cobra"
+)
+
+// stopCmd runs a named project's stop hooks and kills its tmux session.
+var stopCmd = &cobra.Command{
+	Use:   "stop <project>",
+	Short: "Run a named project's stop hooks and kill its session",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := args[0]
+
+		if !tmux.GetTmuxSessionSet()[name] {
+			return fmt.Errorf("no running session named %q", name)
+		}
+
+		layout, settings, err := projectcfg.LoadNamed(name)
+		if err != nil {
+			return err
+		}
+
+		if len(layout.Hooks.Stop) > 0 {
+			root, err := utility.ResolvePath(layout.Root)
+			if err != nil {
+				return err
+			}
+			layout.Root = root
+
+			overrides, err := parseSetFlags(setFlags)
+			if err != nil {
+				return err
+			}
+			settings = projectcfg.MergeSettings(settings, overrides)
+
+			vars := interpolate.Vars{SessionName: name, SessionPath: root, ProjectRoot: root, Settings: settings}
+
+			resolvedSettings, err := interpolate.ResolveSettings(settings, vars)
+			if err != nil {
+				return fmt.Errorf("failed to resolve settings: %w", err)
+			}
+			vars.Settings = resolvedSettings
+
+			layout, err = interpolate.ExpandLayout(layout, vars)
+			if err != nil {
+				return fmt.Errorf("failed to expand project layout: %w", err)
+			}
+
+			if err := tmux.RunStopHooks(root, layout.Hooks.Stop); err != nil {
+				return fmt.Errorf("stop hook failed: %w", err)
+			}
+		}
+
+		return tmux.KillSession(name)
+	},
+}
+
+func init() { // {{{
+	rootCmd.AddCommand(stopCmd)
+} // }}}
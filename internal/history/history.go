@@ -0,0 +1,202 @@
+// SPDX-License-Identifier: MIT
+// © 2025 Austin Gause <a.gause@outlook.com>
+
+// Package history tracks how often and how recently each picker entry has
+// been chosen, so the picker list can be ordered by frecency (frequency +
+// recency) instead of purely alphabetically, mirroring z/zoxide.
+package history
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultHalfLife is used when the config doesn't set frecency_half_life.
+const DefaultHalfLife = 72 * time.Hour
+
+// Entry is one display name's usage record.
+type Entry struct {
+	Count    int   `json:"count"`
+	LastUsed int64 `json:"last_used"`
+}
+
+// Store maps display name to its usage record.
+type Store map[string]Entry
+
+// Path returns $XDG_STATE_HOME/tms/history.json (falling back to
+// ~/.local/state/tms/history.json).
+func Path() (string, error) {
+	stateHome := os.Getenv("XDG_STATE_HOME")
+	if stateHome == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve home directory: %w", err)
+		}
+		stateHome = filepath.Join(home, ".local", "state")
+	}
+
+	return filepath.Join(stateHome, "tms", "history.json"), nil
+}
+
+// Load reads the history store, returning an empty one if it doesn't
+// exist yet.
+func Load() (Store, error) {
+	path, err := Path()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return Store{}, nil
+		}
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	store := Store{}
+	if err := json.Unmarshal(data, &store); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	return store, nil
+}
+
+// Save writes the history store, creating its parent directory if needed.
+func Save(store Store) error {
+	path, err := Path()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(store, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal history: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create history directory: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// Record bumps name's usage count and last-used time to now.
+func (s Store) Record(name string, now time.Time) {
+	entry := s[name]
+	entry.Count++
+	entry.LastUsed = now.Unix()
+	s[name] = entry
+}
+
+// Forget removes name from the store.
+func (s Store) Forget(name string) {
+	delete(s, name)
+}
+
+// score weights an entry's use count by an exponential decay of how long
+// ago it was last used, with a configurable half-life.
+func score(entry Entry, now time.Time, halfLife time.Duration) float64 {
+	if entry.Count == 0 || halfLife <= 0 {
+		return 0
+	}
+
+	age := now.Sub(time.Unix(entry.LastUsed, 0))
+	decay := math.Pow(0.5, age.Hours()/halfLife.Hours())
+
+	return float64(entry.Count) * decay
+}
+
+// Sort mode is the sort: config value.
+const (
+	SortAlpha     = "alpha"
+	SortFrecency  = "frecency"
+	SortHybrid    = "hybrid"
+	defaultSortBy = SortHybrid
+)
+
+// Sort orders names for display. isTmux identifies the "[TMUX]"-prefixed
+// existing-session entries, which stay pinned to the front in "alpha" and
+// "hybrid" modes to preserve the original behavior of favoring sessions
+// that are already running.
+//
+//   - alpha: tmux sessions first, then everything else alphabetically.
+//   - frecency: every entry ranked purely by descending frecency, ties
+//     broken alphabetically.
+//   - hybrid: tmux sessions first, then the rest by descending frecency,
+//     ties broken alphabetically.
+func Sort(names []string, isTmux func(string) bool, store Store, mode string, halfLife time.Duration) []string {
+	if mode == "" {
+		mode = defaultSortBy
+	}
+	if halfLife <= 0 {
+		halfLife = DefaultHalfLife
+	}
+
+	now := time.Now()
+	byAlpha := func(a, b string) bool { return a < b }
+
+	if mode == SortFrecency {
+		sorted := append([]string(nil), names...)
+		sort.SliceStable(sorted, func(i, j int) bool {
+			return rankLess(sorted[i], sorted[j], store, now, halfLife, byAlpha)
+		})
+		return sorted
+	}
+
+	var tmuxNames, rest []string
+	for _, name := range names {
+		if isTmux(name) {
+			tmuxNames = append(tmuxNames, name)
+		} else {
+			rest = append(rest, name)
+		}
+	}
+	sort.Strings(tmuxNames)
+
+	if mode == SortAlpha {
+		sort.Strings(rest)
+	} else {
+		sort.SliceStable(rest, func(i, j int) bool {
+			return rankLess(rest[i], rest[j], store, now, halfLife, byAlpha)
+		})
+	}
+
+	return append(tmuxNames, rest...)
+}
+
+// rankLess orders a before b by descending frecency, falling back to alpha
+// on a tie (including the common case of neither having any history).
+func rankLess(a, b string, store Store, now time.Time, halfLife time.Duration, byAlpha func(a, b string) bool) bool {
+	scoreA, scoreB := score(store[a], now, halfLife), score(store[b], now, halfLife)
+	if scoreA != scoreB {
+		return scoreA > scoreB
+	}
+	return byAlpha(a, b)
+}
+
+// ParseHalfLife parses the frecency_half_life config value (a
+// time.ParseDuration string like "72h"), falling back to DefaultHalfLife
+// when empty or invalid.
+func ParseHalfLife(s string) time.Duration {
+	if strings.TrimSpace(s) == "" {
+		return DefaultHalfLife
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return DefaultHalfLife
+	}
+
+	return d
+}
@@ -0,0 +1,177 @@
+// SPDX-License-Identifier: MIT
+// © 2025 Austin Gause <a.gause@outlook.com>
+
+// Package interpolate expands ${VAR} and {{ .Var }} references found in
+// session layout strings (window names, pane commands, paths, hooks)
+// against the process environment, a config-supplied settings map, and a
+// handful of built-in variables describing the session being created.
+package interpolate
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/Pairadux/Tmux-Sessionizer/internal/models"
+)
+
+// Vars is the set of values a layout string can reference.
+type Vars struct {
+	SessionName string
+	SessionPath string
+	ProjectRoot string
+	Settings    map[string]string
+}
+
+// lookup resolves a bare variable name against the built-ins, then the
+// settings map, then the process environment, in that order.
+func (v Vars) lookup(key string) (string, bool) {
+	switch key {
+	case "SessionName":
+		return v.SessionName, true
+	case "SessionPath":
+		return v.SessionPath, true
+	case "ProjectRoot":
+		return v.ProjectRoot, true
+	}
+
+	if val, ok := v.Settings[key]; ok {
+		return val, true
+	}
+
+	return os.LookupEnv(key)
+}
+
+// Expand resolves every {{ .Var }} reference in s via text/template, then
+// every remaining ${VAR} reference via os.Expand, against vars. It returns
+// an error naming any reference that can't be resolved rather than
+// silently substituting an empty string.
+func Expand(s string, vars Vars) (string, error) {
+	tmpl, err := template.New("value").Option("missingkey=error").Parse(s)
+	if err != nil {
+		return "", fmt.Errorf("invalid template %q: %w", s, err)
+	}
+
+	data := map[string]any{
+		"SessionName": vars.SessionName,
+		"SessionPath": vars.SessionPath,
+		"ProjectRoot": vars.ProjectRoot,
+	}
+	for k, v := range vars.Settings {
+		data[k] = v
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to expand %q: %w", s, err)
+	}
+
+	var missing []string
+	expanded := os.Expand(buf.String(), func(key string) string {
+		val, ok := vars.lookup(key)
+		if !ok {
+			missing = append(missing, key)
+		}
+		return val
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("undefined variable(s) in %q: %s", s, strings.Join(missing, ", "))
+	}
+
+	return expanded, nil
+}
+
+// ResolveSettings expands every value in raw against vars and against the
+// other (already-resolved) entries in raw, so one setting can reference
+// another. It iterates to a fixed point, failing with a cycle error if
+// settings keep changing after enough passes to rule out a typo instead.
+func ResolveSettings(raw map[string]string, vars Vars) (map[string]string, error) {
+	resolved := make(map[string]string, len(raw))
+	for k, v := range raw {
+		resolved[k] = v
+	}
+
+	maxPasses := len(resolved) + 1
+	for pass := 0; pass < maxPasses+1; pass++ {
+		changed := false
+		next := make(map[string]string, len(resolved))
+
+		scoped := vars
+		scoped.Settings = resolved
+
+		for k, v := range resolved {
+			expanded, err := Expand(v, scoped)
+			if err != nil {
+				return nil, err
+			}
+
+			if expanded != resolved[k] {
+				changed = true
+			}
+			next[k] = expanded
+		}
+
+		resolved = next
+		if !changed {
+			return resolved, nil
+		}
+	}
+
+	return nil, fmt.Errorf("settings contain a recursive reference that never resolves")
+}
+
+// ExpandLayout returns a copy of layout with every string field
+// interpolated against vars.
+func ExpandLayout(layout models.SessionLayout, vars Vars) (models.SessionLayout, error) {
+	var err error
+	expand := func(s string) string {
+		if err != nil || s == "" {
+			return s
+		}
+		var out string
+		out, err = Expand(s, vars)
+		return out
+	}
+	expandAll := func(ss []string) []string {
+		if err != nil || len(ss) == 0 {
+			return ss
+		}
+		out := make([]string, len(ss))
+		for i, s := range ss {
+			out[i] = expand(s)
+		}
+		return out
+	}
+
+	layout.Root = expand(layout.Root)
+	layout.StartupWindow = expand(layout.StartupWindow)
+	layout.Hooks.BeforeStart = expandAll(layout.Hooks.BeforeStart)
+	layout.Hooks.OnProjectStart = expandAll(layout.Hooks.OnProjectStart)
+	layout.Hooks.Stop = expandAll(layout.Hooks.Stop)
+
+	windows := make([]models.Window, len(layout.Windows))
+	for i, window := range layout.Windows {
+		window.Name = expand(window.Name)
+		window.Root = expand(window.Root)
+		window.Layout = expand(window.Layout)
+
+		panes := make([]models.Pane, len(window.Panes))
+		for j, pane := range window.Panes {
+			pane.Root = expand(pane.Root)
+			pane.Commands = expandAll(pane.Commands)
+			panes[j] = pane
+		}
+		window.Panes = panes
+
+		windows[i] = window
+	}
+	layout.Windows = windows
+
+	if err != nil {
+		return models.SessionLayout{}, err
+	}
+
+	return layout, nil
+}
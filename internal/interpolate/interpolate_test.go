@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: MIT
+// © 2025 Austin Gause <a.gause@outlook.com>
+
+package interpolate
+
+import (
+	"testing"
+
+	"github.com/Pairadux/Tmux-Sessionizer/internal/models"
+)
+
+func TestExpand(t *testing.T) {
+	vars := Vars{
+		SessionName: "demo",
+		SessionPath: "/home/demo",
+		Settings:    map[string]string{"EDITOR": "nvim"},
+	}
+
+	tests := []struct {
+		name    string
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{name: "builtin via template", in: "{{ .SessionName }}", want: "demo"},
+		{name: "builtin via dollar", in: "${SessionPath}/src", want: "/home/demo/src"},
+		{name: "setting via template", in: "{{ .EDITOR }}", want: "nvim"},
+		{name: "setting via dollar", in: "${EDITOR} .", want: "nvim ."},
+		{name: "plain string", in: "no vars here", want: "no vars here"},
+		{name: "undefined dollar var errors", in: "${NOPE}", wantErr: true},
+		{name: "undefined template var errors", in: "{{ .Nope }}", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Expand(tt.in, vars)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Expand(%q) = %q, want error", tt.in, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expand(%q) returned unexpected error: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Errorf("Expand(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveSettingsRecursiveReferences(t *testing.T) {
+	raw := map[string]string{
+		"ROOT":   "/srv/app",
+		"LOGDIR": "${ROOT}/log",
+		"LATEST": "${LOGDIR}/latest",
+	}
+	vars := Vars{SessionName: "demo"}
+
+	resolved, err := ResolveSettings(raw, vars)
+	if err != nil {
+		t.Fatalf("ResolveSettings returned unexpected error: %v", err)
+	}
+
+	want := map[string]string{
+		"ROOT":   "/srv/app",
+		"LOGDIR": "/srv/app/log",
+		"LATEST": "/srv/app/log/latest",
+	}
+	for k, v := range want {
+		if resolved[k] != v {
+			t.Errorf("resolved[%q] = %q, want %q", k, resolved[k], v)
+		}
+	}
+}
+
+func TestResolveSettingsNestedMap(t *testing.T) {
+	raw := map[string]string{
+		"A": "${B}-${C}",
+		"B": "${C}-b",
+		"C": "c",
+	}
+	vars := Vars{}
+
+	resolved, err := ResolveSettings(raw, vars)
+	if err != nil {
+		t.Fatalf("ResolveSettings returned unexpected error: %v", err)
+	}
+
+	if resolved["C"] != "c" {
+		t.Errorf("resolved[C] = %q, want %q", resolved["C"], "c")
+	}
+	if resolved["B"] != "c-b" {
+		t.Errorf("resolved[B] = %q, want %q", resolved["B"], "c-b")
+	}
+	if resolved["A"] != "c-b-c" {
+		t.Errorf("resolved[A] = %q, want %q", resolved["A"], "c-b-c")
+	}
+}
+
+func TestResolveSettingsCycleErrors(t *testing.T) {
+	raw := map[string]string{
+		"A": "x${B}",
+		"B": "y${A}",
+	}
+
+	if _, err := ResolveSettings(raw, Vars{}); err == nil {
+		t.Fatal("ResolveSettings with a cyclic reference = nil error, want error")
+	}
+}
+
+func TestExpandLayout(t *testing.T) {
+	layout := models.SessionLayout{
+		Root: "${ROOT}",
+		Windows: []models.Window{
+			{
+				Name: "{{ .SessionName }}-editor",
+				Root: "${ROOT}/src",
+				Panes: []models.Pane{
+					{Commands: []string{"${EDITOR} ."}},
+				},
+			},
+		},
+	}
+	vars := Vars{
+		SessionName: "demo",
+		Settings:    map[string]string{"ROOT": "/srv/app", "EDITOR": "nvim"},
+	}
+
+	expanded, err := ExpandLayout(layout, vars)
+	if err != nil {
+		t.Fatalf("ExpandLayout returned unexpected error: %v", err)
+	}
+
+	if expanded.Root != "/srv/app" {
+		t.Errorf("Root = %q, want %q", expanded.Root, "/srv/app")
+	}
+	if expanded.Windows[0].Name != "demo-editor" {
+		t.Errorf("Windows[0].Name = %q, want %q", expanded.Windows[0].Name, "demo-editor")
+	}
+	if expanded.Windows[0].Root != "/srv/app/src" {
+		t.Errorf("Windows[0].Root = %q, want %q", expanded.Windows[0].Root, "/srv/app/src")
+	}
+	if expanded.Windows[0].Panes[0].Commands[0] != "nvim ." {
+		t.Errorf("Panes[0].Commands[0] = %q, want %q", expanded.Windows[0].Panes[0].Commands[0], "nvim .")
+	}
+}
+
+func TestExpandLayoutUndefinedVariableErrors(t *testing.T) {
+	layout := models.SessionLayout{Root: "${NOPE}"}
+
+	if _, err := ExpandLayout(layout, Vars{}); err == nil {
+		t.Fatal("ExpandLayout with an undefined variable = nil error, want error")
+	}
+}
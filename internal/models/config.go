@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: MIT
+// © 2025 Austin Gause <a.gause@outlook.com>
+
+package models
+
+// Config is the top level structure unmarshalled from the user's
+// $XDG_CONFIG_HOME/tms/config.yaml.
+type Config struct {
+	ScanDirs          []ScanDir     `mapstructure:"scan_dirs"`
+	EntryDirs         []string      `mapstructure:"entry_dirs"`
+	IgnoreDirs        []string      `mapstructure:"ignore_dirs"`
+	FallbackSession   Session       `mapstructure:"fallback_session"`
+	TmuxBase          string        `mapstructure:"tmux_base"`
+	TmuxSessionPrefix string        `mapstructure:"tmux_session_prefix"`
+	DefaultDepth      int           `mapstructure:"default_depth"`
+	SessionLayout     SessionLayout `mapstructure:"session_layout"`
+	Editor            string        `mapstructure:"editor"`
+
+	// Picker selects which backend (fzf, sk, fzy, gum, or the pure-Go
+	// builtin) is used for the interactive directory picker. Empty defaults
+	// to "fzf".
+	Picker string `mapstructure:"picker"`
+
+	// Sort controls how the picker list is ordered: "alpha", "frecency", or
+	// "hybrid" (existing tmux sessions first, then frecency). Empty
+	// defaults to "hybrid". FrecencyHalfLife is a time.ParseDuration string
+	// (e.g. "72h") controlling how fast frecency scores decay.
+	Sort             string `mapstructure:"sort"`
+	FrecencyHalfLife string `mapstructure:"frecency_half_life"`
+
+	// Settings and Env are equivalent ways of declaring template variables
+	// for ${VAR}/{{ .Var }} interpolation in SessionLayout strings; Env
+	// wins where a key is set in both.
+	Settings map[string]string `mapstructure:"settings"`
+	Env      map[string]string `mapstructure:"env"`
+}
+
+// TemplateSettings merges Settings and Env into a single map for use as
+// interpolation variables, with Env taking precedence on key collisions.
+func (c Config) TemplateSettings() map[string]string {
+	merged := make(map[string]string, len(c.Settings)+len(c.Env))
+	for k, v := range c.Settings {
+		merged[k] = v
+	}
+	for k, v := range c.Env {
+		merged[k] = v
+	}
+	return merged
+}
+
+// ScanDir describes a directory that should be scanned for sub-directory
+// entries, optionally under a short alias prefix and at its own depth.
+type ScanDir struct {
+	Path  string `mapstructure:"path"`
+	Alias string `mapstructure:"alias"`
+	Depth int    `mapstructure:"depth"`
+}
+
+// GetDepth resolves the effective scan depth for this directory, preferring
+// the directory's own configured depth, then the --depth flag, then the
+// global default_depth.
+func (s ScanDir) GetDepth(flagDepth, defaultDepth int) int {
+	if s.Depth > 0 {
+		return s.Depth
+	}
+	if flagDepth > 0 {
+		return flagDepth
+	}
+	return defaultDepth
+}
+
+// PathInfo carries a resolved directory path alongside the alias prefix it
+// was discovered under, used when building display names.
+type PathInfo struct {
+	Path   string
+	Prefix string
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: MIT
+// © 2025 Austin Gause <a.gause@outlook.com>
+
+package models
+
+// Session describes a tmux session to be created or attached to.
+type Session struct {
+	Name   string        `mapstructure:"name" yaml:"name"`
+	Path   string        `mapstructure:"path" yaml:"path"`
+	Layout SessionLayout `mapstructure:"layout" yaml:"layout"`
+}
+
+// SessionLayout is the ordered set of windows created when a session is
+// started, either from the global config or from a project file. Root is
+// the working directory windows/panes fall back to when they don't set
+// their own, and the Hooks run as the layout is brought up.
+type SessionLayout struct {
+	Root          string   `mapstructure:"root" yaml:"root,omitempty"`
+	Windows       []Window `mapstructure:"windows" yaml:"windows"`
+	Hooks         Hooks    `mapstructure:"hooks" yaml:"hooks,omitempty"`
+	StartupWindow string   `mapstructure:"startup_window" yaml:"startup_window,omitempty"`
+}
+
+// Window describes a single tmux window, the tmux layout applied across its
+// panes (e.g. "even-horizontal", "main-vertical", "tiled"), and the panes
+// laid out inside it.
+type Window struct {
+	Name   string `mapstructure:"name" yaml:"name"`
+	Root   string `mapstructure:"root" yaml:"root,omitempty"`
+	Layout string `mapstructure:"layout" yaml:"layout,omitempty"`
+	Panes  []Pane `mapstructure:"panes" yaml:"panes,omitempty"`
+}
+
+// Pane describes a single tmux pane: the direction it's split from its
+// predecessor and the commands run in it once created, in order.
+type Pane struct {
+	Split    string   `mapstructure:"split" yaml:"split,omitempty"`
+	Root     string   `mapstructure:"root" yaml:"root,omitempty"`
+	Commands []string `mapstructure:"commands" yaml:"commands,omitempty"`
+}
+
+// Hooks are shell commands run at specific points while a session layout is
+// being brought up, each executed with the session/project root as CWD.
+//
+// BeforeStart runs once before any window is created. OnProjectStart runs
+// once the full layout exists, after the last window/pane has been created.
+// Stop runs when the session is torn down.
+type Hooks struct {
+	BeforeStart    []string `mapstructure:"before_start" yaml:"before_start,omitempty"`
+	OnProjectStart []string `mapstructure:"on_project_start" yaml:"on_project_start,omitempty"`
+	Stop           []string `mapstructure:"stop" yaml:"stop,omitempty"`
+}
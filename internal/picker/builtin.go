@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: MIT
+// © 2025 Austin Gause <a.gause@outlook.com>
+
+package picker
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// builtinPicker is a pure-Go picker for when no external fuzzy finder is
+// installed (e.g. over a minimal SSH connection). It is used only when
+// selected with --picker or picker: builtin; tms does not switch to it on
+// its own when fzf is missing. It renders the
+// same sorted, "[TMUX]"-prefixed list the external backends are given, and
+// previews the highlighted entry's directory.
+type builtinPicker struct{}
+
+func init() { // {{{
+	register("builtin", "", func() Picker { return builtinPicker{} })
+} // }}}
+
+func (builtinPicker) Select(items []Item) (string, error) {
+	if len(items) == 0 {
+		return "", ErrCancelled
+	}
+
+	model, err := tea.NewProgram(newBuiltinModel(items)).Run()
+	if err != nil {
+		return "", fmt.Errorf("builtin picker: %w", err)
+	}
+
+	final := model.(builtinModel)
+	if final.cancelled {
+		return "", ErrCancelled
+	}
+
+	return final.items[final.cursor].Label, nil
+}
+
+type builtinModel struct {
+	items     []Item
+	cursor    int
+	cancelled bool
+}
+
+func newBuiltinModel(items []Item) builtinModel {
+	return builtinModel{items: items}
+}
+
+func (m builtinModel) Init() tea.Cmd { return nil }
+
+func (m builtinModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	switch keyMsg.String() {
+	case "ctrl+c", "esc":
+		m.cancelled = true
+		return m, tea.Quit
+	case "up", "k":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "down", "j":
+		if m.cursor < len(m.items)-1 {
+			m.cursor++
+		}
+	case "enter":
+		return m, tea.Quit
+	}
+
+	return m, nil
+}
+
+func (m builtinModel) View() string {
+	var b strings.Builder
+
+	for i, item := range m.items {
+		prefix := "  "
+		if i == m.cursor {
+			prefix = "> "
+		}
+		fmt.Fprintf(&b, "%s%s\n", prefix, item.Label)
+	}
+
+	b.WriteString("\n")
+	b.WriteString(preview(m.items[m.cursor].Path))
+
+	return b.String()
+}
+
+// preview shows a short directory listing for the highlighted item, best
+// effort only — a failure to preview shouldn't block selection.
+func preview(path string) string {
+	if path == "" {
+		return ""
+	}
+
+	out, err := exec.Command("ls", path).Output()
+	if err != nil {
+		return ""
+	}
+
+	return string(out)
+}
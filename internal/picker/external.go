@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: MIT
+// © 2025 Austin Gause <a.gause@outlook.com>
+
+package picker
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// externalPicker shells out to a binary that reads newline-separated
+// labels on stdin and prints the chosen one to stdout, in the fzf/skim/fzy
+// convention.
+type externalPicker struct {
+	binary string
+	args   []string
+}
+
+func (p externalPicker) Select(items []Item) (string, error) {
+	cmd := exec.Command(p.binary, p.args...)
+	cmd.Stdin = strings.NewReader(joinLabels(items))
+	cmd.Stderr = os.Stderr
+
+	var out bytes.Buffer
+	cmd.Stdout = &out
+
+	err := cmd.Run()
+	result := strings.TrimSpace(out.String())
+
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && result == "" {
+			return "", ErrCancelled
+		}
+		return "", fmt.Errorf("%s: %w", p.binary, err)
+	}
+
+	if result == "" {
+		return "", ErrCancelled
+	}
+
+	return result, nil
+}
+
+func joinLabels(items []Item) string {
+	labels := make([]string, len(items))
+	for i, item := range items {
+		labels[i] = item.Label
+	}
+	return strings.Join(labels, "\n")
+}
+
+func init() { // {{{
+	register("fzf", "fzf", func() Picker { return externalPicker{binary: "fzf"} })
+	register("sk", "sk", func() Picker { return externalPicker{binary: "sk"} })
+	register("fzy", "fzy", func() Picker { return externalPicker{binary: "fzy"} })
+	register("gum", "gum", func() Picker { return externalPicker{binary: "gum", args: []string{"choose"}} })
+} // }}}
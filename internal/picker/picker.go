@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: MIT
+// © 2025 Austin Gause <a.gause@outlook.com>
+
+// Package picker abstracts the interactive fuzzy-finder tms shells out to
+// when no session name is given on the command line, so it isn't tied to
+// any one external binary.
+package picker
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ErrCancelled is returned by Select when the user backs out of the picker
+// (Esc/Ctrl-C) without choosing anything.
+var ErrCancelled = errors.New("user cancelled")
+
+// Item is a single entry offered to the user, its Label being what's shown
+// and matched against, and Path being the directory it previews.
+type Item struct {
+	Label string
+	Path  string
+}
+
+// Picker selects one Label out of items, or returns ErrCancelled.
+type Picker interface {
+	Select(items []Item) (string, error)
+}
+
+// factories and binaries are populated by each backend's init().
+var (
+	factories = map[string]func() Picker{}
+	binaries  = map[string]string{}
+)
+
+// register adds a named backend to the registry. binary is the external
+// executable it shells out to, or "" for a pure-Go backend.
+func register(name string, binary string, factory func() Picker) {
+	factories[name] = factory
+	binaries[name] = binary
+}
+
+// Get returns the named backend, or an error listing the known ones.
+func Get(name string) (Picker, error) {
+	factory, ok := factories[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown picker %q (available: %s)", name, joinNames())
+	}
+	return factory(), nil
+}
+
+// Binary returns the external executable the named backend requires, or ""
+// if it has no external dependency (or the name is unknown).
+func Binary(name string) string {
+	return binaries[name]
+}
+
+// Names returns the registered backend names, sorted alphabetically.
+func Names() []string {
+	names := make([]string, 0, len(factories))
+	for name := range factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func joinNames() string {
+	names := Names()
+	out := ""
+	for i, name := range names {
+		if i > 0 {
+			out += ", "
+		}
+		out += name
+	}
+	return out
+}
@@ -0,0 +1,231 @@
+// SPDX-License-Identifier: MIT
+// © 2025 Austin Gause <a.gause@outlook.com>
+
+package projectcfg
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/Pairadux/Tmux-Sessionizer/internal/models"
+
+	"gopkg.in/yaml.v3"
+)
+
+// candidateFiles are checked, in order, relative to a session's path.
+var candidateFiles = []string{
+	".tms.yaml",
+	filepath.Join(".tms", "config.yaml"),
+}
+
+// projectFile is the on-disk schema for a per-directory project config. It
+// mirrors models.SessionLayout directly so the same file can later be
+// dropped into ~/.config/tms/projects/<name>.yaml unchanged.
+type projectFile struct {
+	Root          string            `yaml:"root"`
+	Windows       []models.Window   `yaml:"windows"`
+	Hooks         models.Hooks      `yaml:"hooks"`
+	StartupWindow string            `yaml:"startup_window"`
+	Settings      map[string]string `yaml:"settings"`
+	Env           map[string]string `yaml:"env"`
+}
+
+// settings merges a project file's settings and env maps, Env winning on
+// collisions, matching models.Config.TemplateSettings.
+func (f projectFile) settings() map[string]string {
+	merged := make(map[string]string, len(f.Settings)+len(f.Env))
+	for k, v := range f.Settings {
+		merged[k] = v
+	}
+	for k, v := range f.Env {
+		merged[k] = v
+	}
+	return merged
+}
+
+// Load looks for a .tms.yaml or .tms/config.yaml file under dir and parses
+// it into a SessionLayout plus its settings map. It returns ok=false (with
+// a nil error) when no project file is present, so callers can fall back
+// to the global layout.
+func Load(dir string) (layout models.SessionLayout, settings map[string]string, ok bool, err error) {
+	path, found := find(dir)
+	if !found {
+		return models.SessionLayout{}, nil, false, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return models.SessionLayout{}, nil, false, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var file projectFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return models.SessionLayout{}, nil, false, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	if err := validate(file); err != nil {
+		return models.SessionLayout{}, nil, false, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return toLayout(file), file.settings(), true, nil
+}
+
+func toLayout(file projectFile) models.SessionLayout {
+	return models.SessionLayout{
+		Root:          file.Root,
+		Windows:       file.Windows,
+		Hooks:         file.Hooks,
+		StartupWindow: file.StartupWindow,
+	}
+}
+
+// find returns the first candidate project file that exists under dir.
+func find(dir string) (string, bool) {
+	for _, candidate := range candidateFiles {
+		path := filepath.Join(dir, candidate)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return path, true
+		}
+	}
+
+	return "", false
+}
+
+// validate checks a parsed project file for the mistakes users are most
+// likely to make, returning an error that points at the offending field.
+func validate(file projectFile) error {
+	if len(file.Windows) == 0 {
+		return fmt.Errorf("windows must have at least one entry")
+	}
+
+	for i, window := range file.Windows {
+		if window.Name == "" {
+			return fmt.Errorf("windows[%d]: name is required", i)
+		}
+
+		for j, pane := range window.Panes {
+			switch pane.Split {
+			case "", "horizontal", "vertical":
+			default:
+				return fmt.Errorf("windows[%d].panes[%d]: split must be \"horizontal\" or \"vertical\", got %q", i, j, pane.Split)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Merge overrides base with any fields project sets, preferring project's
+// windows/hooks wholesale when present since a project file is expected to
+// describe the full layout rather than patch individual windows.
+func Merge(base, project models.SessionLayout) models.SessionLayout {
+	merged := base
+
+	if project.Root != "" {
+		merged.Root = project.Root
+	}
+	if len(project.Windows) > 0 {
+		merged.Windows = project.Windows
+	}
+	if len(project.Hooks.BeforeStart) > 0 {
+		merged.Hooks.BeforeStart = project.Hooks.BeforeStart
+	}
+	if len(project.Hooks.OnProjectStart) > 0 {
+		merged.Hooks.OnProjectStart = project.Hooks.OnProjectStart
+	}
+	if len(project.Hooks.Stop) > 0 {
+		merged.Hooks.Stop = project.Hooks.Stop
+	}
+	if project.StartupWindow != "" {
+		merged.StartupWindow = project.StartupWindow
+	}
+
+	return merged
+}
+
+// MergeSettings layers project settings over base settings, project
+// winning on key collisions.
+func MergeSettings(base, project map[string]string) map[string]string {
+	merged := make(map[string]string, len(base)+len(project))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range project {
+		merged[k] = v
+	}
+	return merged
+}
+
+// ProjectsDir returns ~/.config/tms/projects (honouring $XDG_CONFIG_HOME),
+// the directory `tms start/stop/list` read standalone project files from.
+func ProjectsDir() (string, error) {
+	configDir := os.Getenv("XDG_CONFIG_HOME")
+	if configDir == "" {
+		var err error
+		configDir, err = os.UserConfigDir()
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve config directory: %w", err)
+		}
+	}
+
+	return filepath.Join(configDir, "tms", "projects"), nil
+}
+
+// LoadNamed reads and parses ~/.config/tms/projects/<name>.yaml, returning
+// its layout plus its settings map.
+func LoadNamed(name string) (models.SessionLayout, map[string]string, error) {
+	dir, err := ProjectsDir()
+	if err != nil {
+		return models.SessionLayout{}, nil, err
+	}
+
+	path := filepath.Join(dir, name+".yaml")
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return models.SessionLayout{}, nil, fmt.Errorf("failed to read project %q: %w", name, err)
+	}
+
+	var file projectFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return models.SessionLayout{}, nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	if err := validate(file); err != nil {
+		return models.SessionLayout{}, nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return toLayout(file), file.settings(), nil
+}
+
+// ListNames returns the names (without extension) of every project file in
+// ~/.config/tms/projects, sorted alphabetically.
+func ListNames() ([]string, error) {
+	dir, err := ProjectsDir()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read %s: %w", dir, err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".yaml" {
+			continue
+		}
+		names = append(names, strings.TrimSuffix(entry.Name(), ".yaml"))
+	}
+
+	sort.Strings(names)
+
+	return names, nil
+}
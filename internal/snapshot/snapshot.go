@@ -0,0 +1,275 @@
+// SPDX-License-Identifier: MIT
+// © 2025 Austin Gause <a.gause@outlook.com>
+
+// Package snapshot captures the live state of the tmux server (sessions,
+// windows, and panes) and restores it later, giving tms feature parity
+// with tmux-resurrect. Restore rebuilds each window with its own tmux
+// commands (split one pane per captured pane, then apply the captured
+// layout string); it does not go through the session layout code in
+// internal/tmux.
+package snapshot
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// fieldSep separates -F format fields; a tab is vanishingly unlikely to
+// show up in a pane's cwd or running command, unlike a space.
+const fieldSep = "\t"
+
+// Snapshot is the full captured state of the tmux server at a point in
+// time.
+type Snapshot struct {
+	Sessions []Session `yaml:"sessions"`
+}
+
+// Session is one captured tmux session.
+type Session struct {
+	Name    string   `yaml:"name"`
+	Windows []Window `yaml:"windows"`
+}
+
+// Window is one captured tmux window, including the raw tmux layout
+// string (from #{window_layout}) needed to reproduce its exact pane
+// arrangement on restore.
+type Window struct {
+	Name   string `yaml:"name"`
+	Layout string `yaml:"layout"`
+	Panes  []Pane `yaml:"panes"`
+}
+
+// Pane is one captured tmux pane.
+type Pane struct {
+	Path    string `yaml:"path"`
+	Command string `yaml:"command"`
+}
+
+// Capture reads the current state of every running tmux session.
+func Capture() (Snapshot, error) {
+	sessionNames, err := tmuxLines("list-sessions", "-F", "#S")
+	if err != nil {
+		// No server running: an empty snapshot, not an error.
+		return Snapshot{}, nil
+	}
+
+	snap := Snapshot{Sessions: make([]Session, 0, len(sessionNames))}
+
+	for _, name := range sessionNames {
+		windows, err := captureWindows(name)
+		if err != nil {
+			return Snapshot{}, fmt.Errorf("failed to capture session %s: %w", name, err)
+		}
+		snap.Sessions = append(snap.Sessions, Session{Name: name, Windows: windows})
+	}
+
+	return snap, nil
+}
+
+func captureWindows(sessionName string) ([]Window, error) {
+	lines, err := tmuxLines("list-windows", "-t", sessionName, "-F", strings.Join([]string{"#I", "#W", "#{window_layout}"}, fieldSep))
+	if err != nil {
+		return nil, err
+	}
+
+	windows := make([]Window, 0, len(lines))
+	for _, line := range lines {
+		fields := strings.SplitN(line, fieldSep, 3)
+		if len(fields) != 3 {
+			continue
+		}
+		index, name, layout := fields[0], fields[1], fields[2]
+
+		panes, err := capturePanes(sessionName, index)
+		if err != nil {
+			return nil, err
+		}
+
+		windows = append(windows, Window{Name: name, Layout: layout, Panes: panes})
+	}
+
+	return windows, nil
+}
+
+func capturePanes(sessionName, windowIndex string) ([]Pane, error) {
+	target := fmt.Sprintf("%s:%s", sessionName, windowIndex)
+
+	lines, err := tmuxLines("list-panes", "-t", target, "-F", "#P")
+	if err != nil {
+		return nil, err
+	}
+
+	panes := make([]Pane, 0, len(lines))
+	for _, paneIndex := range lines {
+		paneTarget := fmt.Sprintf("%s.%s", target, paneIndex)
+
+		out, err := exec.Command("tmux", "display", "-p", "-t", paneTarget, strings.Join([]string{"#{pane_current_path}", "#{pane_current_command}"}, fieldSep)).Output()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read pane %s: %w", paneTarget, err)
+		}
+
+		fields := strings.SplitN(strings.TrimSpace(string(out)), fieldSep, 2)
+		pane := Pane{Path: fields[0]}
+		if len(fields) == 2 {
+			pane.Command = fields[1]
+		}
+		panes = append(panes, pane)
+	}
+
+	return panes, nil
+}
+
+func tmuxLines(args ...string) ([]string, error) {
+	out, err := exec.Command("tmux", args...).Output()
+	if err != nil {
+		return nil, err
+	}
+
+	trimmed := strings.TrimSpace(string(out))
+	if trimmed == "" {
+		return nil, nil
+	}
+
+	return strings.Split(trimmed, "\n"), nil
+}
+
+// Dir returns $XDG_DATA_HOME/tms/snapshots (falling back to
+// ~/.local/share/tms/snapshots), where save/restore/daemon read and write
+// snapshot files.
+func Dir() (string, error) {
+	dataHome := os.Getenv("XDG_DATA_HOME")
+	if dataHome == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve home directory: %w", err)
+		}
+		dataHome = filepath.Join(home, ".local", "share")
+	}
+
+	return filepath.Join(dataHome, "tms", "snapshots"), nil
+}
+
+// Save writes snap as YAML to path, creating its parent directory if
+// needed.
+func Save(path string, snap Snapshot) error {
+	data, err := yaml.Marshal(snap)
+	if err != nil {
+		return fmt.Errorf("failed to marshal snapshot: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create snapshot directory: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// Load reads and parses a snapshot YAML file.
+func Load(path string) (Snapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var snap Snapshot
+	if err := yaml.Unmarshal(data, &snap); err != nil {
+		return Snapshot{}, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	return snap, nil
+}
+
+// Restore recreates every session in snap that isn't already running.
+func Restore(snap Snapshot) error {
+	for _, session := range snap.Sessions {
+		if sessionExists(session.Name) {
+			continue
+		}
+
+		if err := restoreSession(session); err != nil {
+			return fmt.Errorf("failed to restore session %s: %w", session.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func sessionExists(name string) bool {
+	return exec.Command("tmux", "has-session", "-t", name).Run() == nil
+}
+
+func restoreSession(session Session) error {
+	if len(session.Windows) == 0 {
+		return run("tmux", "new-session", "-d", "-s", session.Name)
+	}
+
+	first := session.Windows[0]
+	firstRoot := paneRoot(first)
+
+	if err := run("tmux", "new-session", "-d", "-s", session.Name, "-n", first.Name, "-c", firstRoot); err != nil {
+		return err
+	}
+	if err := restoreWindow(session.Name, first); err != nil {
+		return err
+	}
+
+	for _, window := range session.Windows[1:] {
+		if err := run("tmux", "new-window", "-t", session.Name, "-n", window.Name, "-c", paneRoot(window)); err != nil {
+			return err
+		}
+		if err := restoreWindow(session.Name, window); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func restoreWindow(sessionName string, window Window) error {
+	target := fmt.Sprintf("%s:%s", sessionName, window.Name)
+
+	for i, pane := range window.Panes {
+		if i > 0 {
+			if err := run("tmux", "split-window", "-t", target, "-c", pane.Path); err != nil {
+				return err
+			}
+		}
+
+		if pane.Command != "" {
+			if err := run("tmux", "send-keys", "-t", target, pane.Command, "Enter"); err != nil {
+				return err
+			}
+		}
+	}
+
+	if window.Layout != "" {
+		if err := run("tmux", "select-layout", "-t", target, window.Layout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func paneRoot(window Window) string {
+	if len(window.Panes) > 0 {
+		return window.Panes[0].Path
+	}
+	return ""
+}
+
+func run(name string, args ...string) error {
+	cmd := exec.Command(name, args...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: MIT
+// © 2025 Austin Gause <a.gause@outlook.com>
+
+package tmux
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+)
+
+// runHooks runs each shell command in order with dir as its working
+// directory, stopping at the first failure.
+func runHooks(dir string, commands []string) error {
+	for _, c := range commands {
+		cmd := exec.Command("sh", "-c", c)
+		cmd.Dir = dir
+		cmd.Stdin = os.Stdin
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("hook %q failed: %w", c, err)
+		}
+	}
+
+	return nil
+}
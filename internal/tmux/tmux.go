@@ -0,0 +1,258 @@
+// SPDX-License-Identifier: MIT
+// © 2025 Austin Gause <a.gause@outlook.com>
+
+package tmux
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/Pairadux/Tmux-Sessionizer/internal/models"
+)
+
+// GetCurrentTmuxSession returns the name of the tmux session the caller is
+// currently attached to, or "" if not running inside tmux.
+func GetCurrentTmuxSession() string {
+	if os.Getenv("TMUX") == "" {
+		return ""
+	}
+
+	out, err := exec.Command("tmux", "display-message", "-p", "#S").Output()
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(string(out))
+}
+
+// GetTmuxSessionSet returns the set of currently running tmux session names.
+func GetTmuxSessionSet() map[string]bool {
+	sessions := make(map[string]bool)
+
+	out, err := exec.Command("tmux", "list-sessions", "-F", "#S").Output()
+	if err != nil {
+		// No server running yet, or no sessions: treat as empty.
+		return sessions
+	}
+
+	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		if line != "" {
+			sessions[line] = true
+		}
+	}
+
+	return sessions
+}
+
+// sessionExists reports whether a tmux session with the given name is
+// already running.
+func sessionExists(name string) bool {
+	return exec.Command("tmux", "has-session", "-t", name).Run() == nil
+}
+
+// CreateAndSwitchSession creates a tmux session for the given Session
+// (building out its Layout's windows and panes), or simply switches to it
+// if it already exists, then attaches/switches the client to it.
+func CreateAndSwitchSession(cfg *models.Config, session models.Session) error {
+	if !sessionExists(session.Name) {
+		if err := createSession(session); err != nil {
+			return err
+		}
+
+		if session.Layout.StartupWindow != "" {
+			if err := SelectWindow(session.Name, session.Layout.StartupWindow); err != nil {
+				return err
+			}
+		}
+	}
+
+	return Switch(session.Name)
+}
+
+// createSession creates a brand new tmux session from a Session's layout,
+// running its before_start/on_project_start hooks around the build.
+func createSession(session models.Session) error {
+	root := session.Path
+	if session.Layout.Root != "" {
+		root = session.Layout.Root
+	}
+
+	if err := runHooks(root, session.Layout.Hooks.BeforeStart); err != nil {
+		return fmt.Errorf("before_start hook failed: %w", err)
+	}
+
+	windows := session.Layout.Windows
+	if len(windows) == 0 {
+		windows = []models.Window{{Name: session.Name}}
+	}
+
+	first := windows[0]
+	firstRoot := root
+	if first.Root != "" {
+		firstRoot = first.Root
+	}
+
+	args := []string{"new-session", "-d", "-s", session.Name, "-c", firstRoot}
+	if first.Name != "" {
+		args = append(args, "-n", first.Name)
+	}
+
+	if err := run("tmux", args...); err != nil {
+		return fmt.Errorf("failed to create session %s: %w", session.Name, err)
+	}
+
+	if err := createWindow(session.Name, first, firstRoot); err != nil {
+		return err
+	}
+
+	for _, window := range windows[1:] {
+		windowRoot := root
+		if window.Root != "" {
+			windowRoot = window.Root
+		}
+
+		if err := run("tmux", "new-window", "-t", session.Name, "-n", window.Name, "-c", windowRoot); err != nil {
+			return fmt.Errorf("failed to create window %s: %w", window.Name, err)
+		}
+
+		if err := createWindow(session.Name, window, windowRoot); err != nil {
+			return err
+		}
+	}
+
+	if err := runHooks(root, session.Layout.Hooks.OnProjectStart); err != nil {
+		return fmt.Errorf("on_project_start hook failed: %w", err)
+	}
+
+	return nil
+}
+
+// createWindow lays out a window's panes and sends its pane commands. The
+// window itself is assumed to already exist with its first pane in place.
+func createWindow(sessionName string, window models.Window, windowRoot string) error {
+	target := fmt.Sprintf("%s:%s", sessionName, window.Name)
+
+	for i, pane := range window.Panes {
+		paneRoot := windowRoot
+		if pane.Root != "" {
+			paneRoot = pane.Root
+		}
+
+		if i > 0 {
+			splitFlag := "-h"
+			if pane.Split == "vertical" {
+				splitFlag = "-v"
+			}
+
+			if err := run("tmux", "split-window", "-t", target, splitFlag, "-c", paneRoot); err != nil {
+				return fmt.Errorf("failed to split pane in window %s: %w", window.Name, err)
+			}
+		}
+
+		for _, cmd := range pane.Commands {
+			if err := run("tmux", "send-keys", "-t", target, cmd, "Enter"); err != nil {
+				return fmt.Errorf("failed to send command to window %s: %w", window.Name, err)
+			}
+		}
+	}
+
+	if window.Layout != "" {
+		if err := run("tmux", "select-layout", "-t", target, window.Layout); err != nil {
+			return fmt.Errorf("failed to apply layout %s to window %s: %w", window.Layout, window.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// Switch attaches to or switches the client onto the named session,
+// depending on whether we're already inside tmux.
+func Switch(name string) error {
+	if os.Getenv("TMUX") != "" {
+		return run("tmux", "switch-client", "-t", name)
+	}
+	return run("tmux", "attach-session", "-t", name)
+}
+
+// SelectWindow makes windowName the active window in sessionName.
+func SelectWindow(sessionName, windowName string) error {
+	target := fmt.Sprintf("%s:%s", sessionName, windowName)
+	if err := run("tmux", "select-window", "-t", target); err != nil {
+		return fmt.Errorf("failed to select window %s: %w", target, err)
+	}
+	return nil
+}
+
+// windowExists reports whether sessionName already has a window named
+// windowName.
+func windowExists(sessionName, windowName string) bool {
+	out, err := exec.Command("tmux", "list-windows", "-t", sessionName, "-F", "#W").Output()
+	if err != nil {
+		return false
+	}
+
+	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		if line == windowName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// EnsureWindow (re)creates a single window inside an already-running
+// session, replacing it first if a window of the same name exists. This
+// backs `tms start <project>:<window>`, which only wants to rebuild the
+// windows the caller named.
+func EnsureWindow(sessionName, root string, window models.Window) error {
+	windowRoot := root
+	if window.Root != "" {
+		windowRoot = window.Root
+	}
+
+	// Create the replacement before killing the old window: if window.Name
+	// is the session's only window, killing it first would kill the whole
+	// session (tmux sessions can't have zero windows).
+	var oldTarget string
+	if windowExists(sessionName, window.Name) {
+		tempName := window.Name + ".replacing"
+		oldTarget = fmt.Sprintf("%s:%s", sessionName, window.Name)
+		if err := run("tmux", "rename-window", "-t", oldTarget, tempName); err != nil {
+			return fmt.Errorf("failed to rename existing window %s: %w", oldTarget, err)
+		}
+		oldTarget = fmt.Sprintf("%s:%s", sessionName, tempName)
+	}
+
+	if err := run("tmux", "new-window", "-t", sessionName, "-n", window.Name, "-c", windowRoot); err != nil {
+		return fmt.Errorf("failed to create window %s: %w", window.Name, err)
+	}
+
+	if oldTarget != "" {
+		if err := run("tmux", "kill-window", "-t", oldTarget); err != nil {
+			return fmt.Errorf("failed to kill existing window %s: %w", oldTarget, err)
+		}
+	}
+
+	return createWindow(sessionName, window, windowRoot)
+}
+
+// KillSession terminates the named tmux session.
+func KillSession(name string) error {
+	return run("tmux", "kill-session", "-t", name)
+}
+
+// RunStopHooks runs a project's stop hooks with dir as their working
+// directory.
+func RunStopHooks(dir string, commands []string) error {
+	return runHooks(dir, commands)
+}
+
+func run(name string, args ...string) error {
+	cmd := exec.Command(name, args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: MIT
+// © 2025 Austin Gause <a.gause@outlook.com>
+
+package utility
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ResolvePath expands a leading "~" to the user's home directory, expands
+// environment variables, and returns a cleaned absolute path.
+func ResolvePath(path string) (string, error) {
+	path = os.ExpandEnv(path)
+
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve home directory: %w", err)
+		}
+		path = filepath.Join(home, strings.TrimPrefix(path, "~"))
+	}
+
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve path %s: %w", path, err)
+	}
+
+	return filepath.Clean(abs), nil
+}
+
+// GetSubDirs returns the directories found by walking root up to depth
+// levels deep (depth 0 returns only root's immediate children).
+func GetSubDirs(depth int, root string) ([]string, error) {
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat %s: %w", root, err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", root)
+	}
+
+	var dirs []string
+	var walk func(path string, remaining int) error
+	walk = func(path string, remaining int) error {
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			if !entry.IsDir() {
+				continue
+			}
+
+			full := filepath.Join(path, entry.Name())
+			dirs = append(dirs, full)
+
+			if remaining > 0 {
+				if err := walk(full, remaining-1); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+
+	if err := walk(root, depth); err != nil {
+		return nil, err
+	}
+
+	return dirs, nil
+}